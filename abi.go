@@ -0,0 +1,81 @@
+package ethgo
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/abi"
+)
+
+// JS: ethgo.encodeFunctionCall(abiJSON, methodName, args) → "0x.." (selector + encoded args)
+//
+// Lets scripts craft contract calls per iteration with typed inputs instead
+// of pre-encoding `data` in JS with a bundled ethers.js.
+func (m *Module) EncodeFunctionCall(abiJSON string, methodName string, args []interface{}) (string, error) {
+	contractABI, err := abi.NewABI(abiJSON)
+	if err != nil {
+		return "", err
+	}
+	method, ok := contractABI.Methods[methodName]
+	if !ok {
+		return "", fmt.Errorf("method %q not found in ABI", methodName)
+	}
+
+	encodedArgs, err := abi.Encode(args, method.Inputs)
+	if err != nil {
+		return "", fmt.Errorf("encode args: %w", err)
+	}
+	return "0x" + hex.EncodeToString(append(method.ID(), encodedArgs...)), nil
+}
+
+// JS: ethgo.decodeFunctionResult(abiJSON, methodName, dataHex) → object/array
+func (m *Module) DecodeFunctionResult(abiJSON string, methodName string, dataHex string) (interface{}, error) {
+	contractABI, err := abi.NewABI(abiJSON)
+	if err != nil {
+		return nil, err
+	}
+	method, ok := contractABI.Methods[methodName]
+	if !ok {
+		return nil, fmt.Errorf("method %q not found in ABI", methodName)
+	}
+
+	decoded, err := abi.Decode(method.Outputs, decodeHexArg(dataHex))
+	if err != nil {
+		return nil, fmt.Errorf("decode result: %w", err)
+	}
+	return decoded, nil
+}
+
+// JS: ethgo.decodeEventLog(abiJSON, eventName, topics, dataHex) → object
+func (m *Module) DecodeEventLog(abiJSON string, eventName string, topics []string, dataHex string) (interface{}, error) {
+	contractABI, err := abi.NewABI(abiJSON)
+	if err != nil {
+		return nil, err
+	}
+	event, ok := contractABI.Events[eventName]
+	if !ok {
+		return nil, fmt.Errorf("event %q not found in ABI", eventName)
+	}
+
+	log := &ethgo.Log{
+		Data: decodeHexArg(dataHex),
+	}
+	for _, t := range topics {
+		log.Topics = append(log.Topics, ethgo.HexToHash(t))
+	}
+
+	decoded, err := event.ParseLog(log)
+	if err != nil {
+		return nil, fmt.Errorf("decode event log: %w", err)
+	}
+	return decoded, nil
+}
+
+func decodeHexArg(s string) []byte {
+	if len(s) > 2 && s[:2] == "0x" {
+		s = s[2:]
+	}
+	b, _ := hex.DecodeString(s)
+	return b
+}
@@ -0,0 +1,157 @@
+package ethgo
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const erc20ABI = `[
+	{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+func TestEncodeFunctionCall(t *testing.T) {
+	m := &Module{}
+	got, err := m.EncodeFunctionCall(erc20ABI, "transfer", []interface{}{
+		"0x5fbdb2315678afecb367f032d93f642f64180aa3",
+		float64(1000000000000000000),
+	})
+	if err != nil {
+		t.Fatalf("EncodeFunctionCall error: %v", err)
+	}
+
+	// keccak256("transfer(address,uint256)")[:4]
+	wantSelector := "0xa9059cbb"
+	if !strings.HasPrefix(got, wantSelector) {
+		t.Errorf("EncodeFunctionCall() = %s; want prefix %s", got, wantSelector)
+	}
+	// 4-byte selector + 2 * 32-byte words = 68 bytes = 136 hex chars + "0x"
+	if len(got) != 138 {
+		t.Errorf("unexpected encoded length %d: %s", len(got), got)
+	}
+}
+
+func TestEncodeFunctionCallUnknownMethod(t *testing.T) {
+	m := &Module{}
+	if _, err := m.EncodeFunctionCall(erc20ABI, "approve", nil); err == nil {
+		t.Error("expected error for unknown method, got nil")
+	}
+}
+
+const erc20SymbolABI = `[
+	{"type":"function","name":"symbol","inputs":[],"outputs":[{"name":"","type":"string"}]}
+]`
+
+func TestDecodeFunctionResult(t *testing.T) {
+	m := &Module{}
+
+	// ABI encoding of a dynamic "string" return value "USDT": a 32-byte
+	// offset (0x20), a 32-byte length (4), then the data word.
+	data := "0x" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000004" +
+		"5553445400000000000000000000000000000000000000000000000000000000"
+
+	got, err := m.DecodeFunctionResult(erc20SymbolABI, "symbol", data)
+	if err != nil {
+		t.Fatalf("DecodeFunctionResult error: %v", err)
+	}
+
+	decoded, ok := got.(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected map result, got %T: %v", got, got)
+	}
+	if decoded["0"] != "USDT" {
+		t.Errorf("DecodeFunctionResult() = %v; want symbol %q", decoded, "USDT")
+	}
+}
+
+const erc721TokenIdsABI = `[
+	{"type":"function","name":"tokenIds","inputs":[],"outputs":[{"name":"","type":"uint256[]"}]}
+]`
+
+func TestDecodeFunctionResultDynamicArray(t *testing.T) {
+	m := &Module{}
+
+	// ABI encoding of a dynamic "uint256[]" return value [1, 2, 3]: a
+	// 32-byte offset (0x20), a 32-byte length (3), then one word per element.
+	data := "0x" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000003" +
+		"0000000000000000000000000000000000000000000000000000000000000001" +
+		"0000000000000000000000000000000000000000000000000000000000000002" +
+		"0000000000000000000000000000000000000000000000000000000000000003"
+
+	got, err := m.DecodeFunctionResult(erc721TokenIdsABI, "tokenIds", data)
+	if err != nil {
+		t.Fatalf("DecodeFunctionResult error: %v", err)
+	}
+
+	decoded, ok := got.(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected map result, got %T: %v", got, got)
+	}
+	ids, ok := decoded["0"].([]interface{})
+	if !ok {
+		t.Fatalf("expected array result for tokenIds, got %T: %v", decoded["0"], decoded["0"])
+	}
+	if len(ids) != 3 {
+		t.Fatalf("expected 3 token ids, got %d: %v", len(ids), ids)
+	}
+	for i, want := range []string{"1", "2", "3"} {
+		if fmt.Sprint(ids[i]) != want {
+			t.Errorf("tokenIds[%d] = %v; want %s", i, ids[i], want)
+		}
+	}
+}
+
+func TestDecodeFunctionResultUnknownMethod(t *testing.T) {
+	m := &Module{}
+	if _, err := m.DecodeFunctionResult(erc20ABI, "approve", "0x"); err == nil {
+		t.Error("expected error for unknown method, got nil")
+	}
+}
+
+const erc20TransferEventABI = `[
+	{"type":"event","name":"Transfer","inputs":[
+		{"name":"from","type":"address","indexed":true},
+		{"name":"to","type":"address","indexed":true},
+		{"name":"value","type":"uint256","indexed":false}
+	]}
+]`
+
+func TestDecodeEventLog(t *testing.T) {
+	m := &Module{}
+
+	// keccak256("Transfer(address,address,uint256)")
+	topic0 := "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+	topic1 := "0x0000000000000000000000005fbdb2315678afecb367f032d93f642f64180aa3"
+	topic2 := "0x00000000000000000000000070997970c51812dc3a010c7d01b50e0d17dc79c8"
+	data := "0x00000000000000000000000000000000000000000000000000000000000003e8"
+
+	got, err := m.DecodeEventLog(erc20TransferEventABI, "Transfer", []string{topic0, topic1, topic2}, data)
+	if err != nil {
+		t.Fatalf("DecodeEventLog error: %v", err)
+	}
+
+	decoded, ok := got.(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected map result, got %T: %v", got, got)
+	}
+	if !strings.EqualFold(fmt.Sprint(decoded["from"]), "0x5fBDB2315678afecb367f032d93F642f64180aa3") {
+		t.Errorf("decoded indexed field from = %v", decoded["from"])
+	}
+	if !strings.EqualFold(fmt.Sprint(decoded["to"]), "0x70997970C51812dc3A010C7d01b50e0d17dc79C8") {
+		t.Errorf("decoded indexed field to = %v", decoded["to"])
+	}
+	if fmt.Sprint(decoded["value"]) != "1000" {
+		t.Errorf("decoded non-indexed field value = %v; want 1000", decoded["value"])
+	}
+}
+
+func TestDecodeEventLogUnknownEvent(t *testing.T) {
+	m := &Module{}
+	if _, err := m.DecodeEventLog(erc20TransferEventABI, "Approval", nil, "0x"); err == nil {
+		t.Error("expected error for unknown event, got nil")
+	}
+}
@@ -0,0 +1,319 @@
+package ethgo
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/jsonrpc"
+	"github.com/umbracle/ethgo/wallet"
+)
+
+// Client is a pipelined JSON-RPC client with local nonce management, built
+// for high-TPS load tests where a per-tx nonce round-trip would otherwise
+// force each VU to serialize its submissions.
+type Client struct {
+	rpc    *jsonrpc.Client
+	rpcURL string
+	httpc  *http.Client
+
+	mu        sync.Mutex
+	nonces    map[ethgo.Address]uint64
+	addrLocks map[ethgo.Address]*sync.Mutex
+}
+
+// JS: ethgo.newClient(rpcURL, opts) → Client
+func (m *Module) NewClient(rpcURL string, opts map[string]interface{}) (*Client, error) {
+	rpc, err := jsonrpc.NewClient(rpcURL)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := 30 * time.Second
+	if v, ok := opts["timeoutMs"]; ok {
+		timeout = time.Duration(intFromIface(v)) * time.Millisecond
+	}
+
+	return &Client{
+		rpc:       rpc,
+		rpcURL:    rpcURL,
+		httpc:     &http.Client{Timeout: timeout},
+		nonces:    make(map[ethgo.Address]uint64),
+		addrLocks: make(map[ethgo.Address]*sync.Mutex),
+	}, nil
+}
+
+// lockFor returns the per-address mutex used to serialize nonce access for
+// addr, creating it on first use. Only the map lookup is guarded globally;
+// the returned lock itself is held across the (potentially slow) RPC calls
+// in nextNonce/resyncNonceLocked, so unrelated addresses never block each other.
+func (c *Client) lockFor(addr ethgo.Address) *sync.Mutex {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	l, ok := c.addrLocks[addr]
+	if !ok {
+		l = &sync.Mutex{}
+		c.addrLocks[addr] = l
+	}
+	return l
+}
+
+// nextNonce returns the next nonce for addr, pre-fetching it from the node
+// via eth_getTransactionCount(pending) on first use and incrementing it
+// locally thereafter to avoid a round-trip per tx.
+func (c *Client) nextNonce(addr ethgo.Address) (uint64, error) {
+	lock := c.lockFor(addr)
+	lock.Lock()
+	defer lock.Unlock()
+	return c.nextNonceLocked(addr)
+}
+
+// nextNonceLocked is nextNonce's body for a caller that already holds
+// lockFor(addr), so it can be composed with a later resyncNonceLocked call
+// under the same critical section (see SignAndSend).
+func (c *Client) nextNonceLocked(addr ethgo.Address) (uint64, error) {
+	c.mu.Lock()
+	n, ok := c.nonces[addr]
+	c.mu.Unlock()
+	if ok {
+		c.mu.Lock()
+		c.nonces[addr] = n + 1
+		c.mu.Unlock()
+		return n, nil
+	}
+
+	n, err := c.rpc.Eth().GetNonce(addr, ethgo.Pending)
+	if err != nil {
+		return 0, fmt.Errorf("fetch nonce: %w", err)
+	}
+	c.mu.Lock()
+	c.nonces[addr] = n + 1
+	c.mu.Unlock()
+	return n, nil
+}
+
+// resyncNonceLocked discards the locally cached nonce for addr and
+// re-fetches it from the node, used after a send fails. The caller must
+// already hold lockFor(addr) (see SignAndSend).
+func (c *Client) resyncNonceLocked(addr ethgo.Address) error {
+	n, err := c.rpc.Eth().GetNonce(addr, ethgo.Pending)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.nonces[addr] = n
+	c.mu.Unlock()
+	return nil
+}
+
+// maxNonceConflictRetries bounds the exponential-backoff retry loop in
+// SignAndSend so a persistently broken node can't spin a VU forever.
+const maxNonceConflictRetries = 5
+
+// JS: client.signAndSend(tx, privKeyHex) → "0x.." tx hash
+//
+// Assigns tx.nonce from the local cache when omitted, signs as a legacy
+// tx, and sends it. Any send failure re-syncs the cached nonce from the
+// node — since the node never accepted the consumed nonce, the cache
+// would otherwise stay permanently ahead and every later send from this
+// address would carry a gap nonce. The address's nonce lock is held across
+// the whole allocate-sign-send-resync sequence, so a concurrent call for
+// the same address can never observe the stale cached nonce in the window
+// between a failed send and its resync. On "nonce too low"/"replacement
+// underpriced" it additionally retries the sign+send against the
+// re-synced nonce with exponential backoff, up to maxNonceConflictRetries
+// attempts; other errors are returned immediately once the cache is fixed.
+// When the caller sets tx.nonce, the cache is not used: a send error is
+// returned as is, with no resync and no retry.
+func (c *Client) SignAndSend(tx map[string]interface{}, privKeyHex string) (string, error) {
+	key, err := walletFromPrivKeyHex(privKeyHex)
+	if err != nil {
+		return "", err
+	}
+	addr := key.Address()
+
+	explicitNonce, hasExplicitNonce := tx["nonce"]
+
+	backoff := 100 * time.Millisecond
+	var lastErr error
+	for attempt := 0; attempt < maxNonceConflictRetries; attempt++ {
+		var lock *sync.Mutex
+		unlock := func() {
+			if lock != nil {
+				lock.Unlock()
+				lock = nil
+			}
+		}
+
+		if hasExplicitNonce {
+			tx["nonce"] = explicitNonce
+		} else {
+			lock = c.lockFor(addr)
+			lock.Lock()
+			nonce, err := c.nextNonceLocked(addr)
+			if err != nil {
+				unlock()
+				return "", err
+			}
+			tx["nonce"] = nonce
+		}
+
+		m := &Module{}
+		rawHex, err := m.SignLegacyTx(tx, privKeyHex)
+		if err != nil {
+			unlock()
+			return "", err
+		}
+
+		hash, err := c.sendRaw(rawHex)
+		if err == nil {
+			unlock()
+			return hash, nil
+		}
+		if hasExplicitNonce {
+			// A caller-pinned nonce isn't tracked in our cache, so there's
+			// nothing to resync; the caller owns retrying it.
+			return "", err
+		}
+
+		// The node never accepted this nonce, whatever the reason — resync
+		// the cache before releasing the address lock, so no concurrent
+		// caller can grab a nonce derived from the now-stale cached value.
+		lastErr = err
+		rerr := c.resyncNonceLocked(addr)
+		unlock()
+		if rerr != nil {
+			return "", fmt.Errorf("send failed (%v) and nonce resync failed: %w", err, rerr)
+		}
+		if !isNonceConflict(err) {
+			return "", err
+		}
+		time.Sleep(backoff)
+		if backoff < 2*time.Second {
+			backoff *= 2
+		}
+	}
+	return "", fmt.Errorf("gave up after %d attempts on nonce conflict: %w", maxNonceConflictRetries, lastErr)
+}
+
+func (c *Client) sendRaw(rawHex string) (string, error) {
+	hash, err := c.rpc.Eth().SendRawTransaction(decodeHexArg(rawHex))
+	if err != nil {
+		return "", err
+	}
+	return hash.String(), nil
+}
+
+func isNonceConflict(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "nonce too low") || strings.Contains(msg, "replacement underpriced")
+}
+
+type batchRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      int           `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type batchResponse struct {
+	ID     int    `json:"id"`
+	Result string `json:"result"`
+	Error  *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// JS: client.sendRawBatch(rawTxs) → ["0x..", ...] tx hashes in order
+//
+// Submits all rawTxs as a single JSON-RPC batch call instead of one
+// round-trip per tx.
+func (c *Client) SendRawBatch(rawTxs []string) ([]string, error) {
+	batch := make([]batchRequest, len(rawTxs))
+	for i, raw := range rawTxs {
+		batch[i] = batchRequest{
+			JSONRPC: "2.0",
+			ID:      i,
+			Method:  "eth_sendRawTransaction",
+			Params:  []interface{}{raw},
+		}
+	}
+
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.httpc.Post(c.rpcURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var results []batchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return nil, err
+	}
+	if len(results) != len(rawTxs) {
+		return nil, fmt.Errorf("batch response size mismatch: sent %d txs, got %d results", len(rawTxs), len(results))
+	}
+
+	seen := make([]bool, len(rawTxs))
+	hashes := make([]string, len(rawTxs))
+	for _, r := range results {
+		if r.Error != nil {
+			return nil, fmt.Errorf("batch tx %d failed: %s", r.ID, r.Error.Message)
+		}
+		if r.ID < 0 || r.ID >= len(hashes) {
+			return nil, fmt.Errorf("batch response has out-of-range id %d for %d submitted txs", r.ID, len(rawTxs))
+		}
+		if seen[r.ID] {
+			return nil, fmt.Errorf("batch response has duplicate id %d", r.ID)
+		}
+		seen[r.ID] = true
+		hashes[r.ID] = r.Result
+	}
+	for i, ok := range seen {
+		if !ok {
+			return nil, fmt.Errorf("batch response missing result for id %d", i)
+		}
+	}
+	return hashes, nil
+}
+
+// JS: client.waitForReceipt(hash, timeoutMs) → receipt object
+//
+// Polls eth_getTransactionReceipt with exponential backoff until the tx is
+// mined or timeoutMs elapses.
+func (c *Client) WaitForReceipt(hash string, timeoutMs int) (*ethgo.Receipt, error) {
+	deadline := time.Now().Add(time.Duration(timeoutMs) * time.Millisecond)
+	backoff := 100 * time.Millisecond
+
+	for {
+		receipt, err := c.rpc.Eth().GetTransactionReceipt(ethgo.HexToHash(hash))
+		if err == nil && receipt != nil {
+			return receipt, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for receipt of %s", hash)
+		}
+		time.Sleep(backoff)
+		if backoff < 2*time.Second {
+			backoff *= 2
+		}
+	}
+}
+
+func walletFromPrivKeyHex(privKeyHex string) (wallet.Key, error) {
+	pk, err := hex.DecodeString(privKeyHex)
+	if err != nil {
+		return nil, err
+	}
+	return wallet.NewWalletFromPrivKey(pk)
+}
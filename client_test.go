@@ -0,0 +1,260 @@
+package ethgo
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/umbracle/ethgo"
+)
+
+// fakeRPCServer is a minimal single-request JSON-RPC 2.0 transport used to
+// exercise the client's nonce-cache and conflict-retry logic without a live
+// node. eth_getTransactionCount always answers "0x5"; eth_sendRawTransaction
+// fails with failMessage (default "nonce too low") for the first failSends
+// calls, then succeeds.
+type fakeRPCServer struct {
+	getNonceCalls int32
+	sendCalls     int32
+	failSends     int32
+	failMessage   string
+}
+
+func (s *fakeRPCServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&req)
+
+	resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+
+	switch req.Method {
+	case "eth_getTransactionCount":
+		atomic.AddInt32(&s.getNonceCalls, 1)
+		resp["result"] = "0x5"
+	case "eth_sendRawTransaction":
+		n := atomic.AddInt32(&s.sendCalls, 1)
+		if n <= atomic.LoadInt32(&s.failSends) {
+			msg := s.failMessage
+			if msg == "" {
+				msg = "nonce too low"
+			}
+			resp["error"] = map[string]interface{}{"code": -32000, "message": msg}
+		} else {
+			resp["result"] = fmt.Sprintf("0x%064x", n)
+		}
+	default:
+		resp["result"] = nil
+	}
+
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func TestClientNextNonceCachesAcrossCalls(t *testing.T) {
+	srv := &fakeRPCServer{}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	m := &Module{}
+	client, err := m.NewClient(ts.URL, nil)
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	addr := ethgo.HexToAddress("0x5fbdb2315678afecb367f032d93f642f64180aa3")
+
+	n1, err := client.nextNonce(addr)
+	if err != nil {
+		t.Fatalf("nextNonce error: %v", err)
+	}
+	n2, err := client.nextNonce(addr)
+	if err != nil {
+		t.Fatalf("nextNonce error: %v", err)
+	}
+
+	if n2 != n1+1 {
+		t.Errorf("expected nonces to increment locally: got %d then %d", n1, n2)
+	}
+	if got := atomic.LoadInt32(&srv.getNonceCalls); got != 1 {
+		t.Errorf("expected exactly 1 eth_getTransactionCount call (cached after), got %d", got)
+	}
+}
+
+func TestClientSignAndSendRetriesOnNonceConflict(t *testing.T) {
+	srv := &fakeRPCServer{failSends: 1}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	m := &Module{}
+	client, err := m.NewClient(ts.URL, nil)
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	pkBytes := bytes.Repeat([]byte{1}, 32)
+	pkHex := hex.EncodeToString(pkBytes)
+
+	tx := map[string]interface{}{
+		"to":       "0x5fbdb2315678afecb367f032d93f642f64180aa3",
+		"value":    1000,
+		"chainId":  50312,
+		"gas":      21000,
+		"gasPrice": 1000000000,
+	}
+
+	hash, err := client.SignAndSend(tx, pkHex)
+	if err != nil {
+		t.Fatalf("SignAndSend error: %v", err)
+	}
+	if hash == "" {
+		t.Error("expected non-empty tx hash")
+	}
+	if got := atomic.LoadInt32(&srv.sendCalls); got != 2 {
+		t.Errorf("expected 1 failed + 1 retried send (2 calls), got %d", got)
+	}
+}
+
+func TestClientSignAndSendGivesUpOnPersistentConflict(t *testing.T) {
+	srv := &fakeRPCServer{failSends: maxNonceConflictRetries}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	m := &Module{}
+	client, err := m.NewClient(ts.URL, nil)
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	pkBytes := bytes.Repeat([]byte{1}, 32)
+	pkHex := hex.EncodeToString(pkBytes)
+
+	tx := map[string]interface{}{
+		"to":       "0x5fbdb2315678afecb367f032d93f642f64180aa3",
+		"value":    1000,
+		"chainId":  50312,
+		"gas":      21000,
+		"gasPrice": 1000000000,
+	}
+
+	if _, err := client.SignAndSend(tx, pkHex); err == nil {
+		t.Error("expected an error after exhausting nonce-conflict retries, got nil")
+	}
+}
+
+// TestClientSignAndSendResyncsNonceOnNonConflictError verifies that a send
+// failure unrelated to nonce conflicts (e.g. "insufficient funds") still
+// triggers a nonce resync, since the node never accepted the nonce either
+// way. Without this, the local cache would stay one ahead of the chain and
+// every subsequent send from this address would carry a gap nonce.
+func TestClientSignAndSendResyncsNonceOnNonConflictError(t *testing.T) {
+	srv := &fakeRPCServer{failSends: 1, failMessage: "insufficient funds for gas * price + value"}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	m := &Module{}
+	client, err := m.NewClient(ts.URL, nil)
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	pkBytes := bytes.Repeat([]byte{1}, 32)
+	pkHex := hex.EncodeToString(pkBytes)
+
+	tx := map[string]interface{}{
+		"to":       "0x5fbdb2315678afecb367f032d93f642f64180aa3",
+		"value":    1000,
+		"chainId":  50312,
+		"gas":      21000,
+		"gasPrice": 1000000000,
+	}
+
+	// The signer's address, whose nonce SignAndSend caches.
+	key, err := walletFromPrivKeyHex(pkHex)
+	if err != nil {
+		t.Fatalf("walletFromPrivKeyHex error: %v", err)
+	}
+	addr := key.Address()
+
+	if _, err := client.SignAndSend(tx, pkHex); err == nil {
+		t.Fatal("expected the non-conflict send error to be returned, got nil")
+	}
+	if got := atomic.LoadInt32(&srv.sendCalls); got != 1 {
+		t.Errorf("expected a single send attempt for a non-conflict error, got %d", got)
+	}
+	if got := atomic.LoadInt32(&srv.getNonceCalls); got != 2 {
+		t.Errorf("expected the failed send to trigger exactly one resync (2 total getNonce calls), got %d", got)
+	}
+
+	// The cache must now reflect the freshly re-fetched nonce (5), not the
+	// stale locally-incremented value (6) from the failed attempt.
+	n, err := client.nextNonce(addr)
+	if err != nil {
+		t.Fatalf("nextNonce error: %v", err)
+	}
+	if n != 5 {
+		t.Errorf("expected resynced nonce 5 after failed send, got %d", n)
+	}
+}
+
+// rawBatchServer replies to any request with a fixed, literal JSON body,
+// used to simulate a node returning a malformed batch response.
+func rawBatchServer(body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+}
+
+func TestClientSendRawBatchTruncatedResponseErrors(t *testing.T) {
+	// Only one result for two submitted txs.
+	ts := rawBatchServer(`[{"jsonrpc":"2.0","id":0,"result":"0x01"}]`)
+	defer ts.Close()
+
+	m := &Module{}
+	client, err := m.NewClient(ts.URL, nil)
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	if _, err := client.SendRawBatch([]string{"0xaa", "0xbb"}); err == nil {
+		t.Error("expected an error for a truncated batch response, got nil")
+	}
+}
+
+func TestClientSendRawBatchOutOfRangeIDErrors(t *testing.T) {
+	// id 5 is out of range for a 2-tx batch.
+	ts := rawBatchServer(`[{"jsonrpc":"2.0","id":0,"result":"0x01"},{"jsonrpc":"2.0","id":5,"result":"0x02"}]`)
+	defer ts.Close()
+
+	m := &Module{}
+	client, err := m.NewClient(ts.URL, nil)
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	if _, err := client.SendRawBatch([]string{"0xaa", "0xbb"}); err == nil {
+		t.Error("expected an error for an out-of-range batch response id, got nil")
+	}
+}
+
+func TestClientSendRawBatchDuplicateIDErrors(t *testing.T) {
+	// Both results claim id 0; id 1 is never answered.
+	ts := rawBatchServer(`[{"jsonrpc":"2.0","id":0,"result":"0x01"},{"jsonrpc":"2.0","id":0,"result":"0x02"}]`)
+	defer ts.Close()
+
+	m := &Module{}
+	client, err := m.NewClient(ts.URL, nil)
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	if _, err := client.SendRawBatch([]string{"0xaa", "0xbb"}); err == nil {
+		t.Error("expected an error for a duplicate batch response id, got nil")
+	}
+}
@@ -70,6 +70,165 @@ func (m *Module) SignLegacyTx(tx map[string]interface{}, privKeyHex string) (str
 	return "0x" + hex.EncodeToString(raw), nil
 }
 
+// JS: ethgo.signAccessListTx({nonce, gasPrice, gas, to, value, data, chainId, accessList}, privKeyHex) → hex string
+func (m *Module) SignAccessListTx(tx map[string]interface{}, privKeyHex string) (string, error) {
+	t := &ethgo.Transaction{
+		Type: ethgo.TransactionAccessList,
+	}
+	if v, ok := tx["nonce"]; ok {
+		t.Nonce = uint64(intFromIface(v))
+	}
+	if v, ok := tx["gasPrice"]; ok {
+		t.GasPrice = uint64(intFromIface(v))
+	}
+	if v, ok := tx["gas"]; ok {
+		t.Gas = uint64(intFromIface(v))
+	}
+	if v, ok := tx["to"]; ok {
+		addr := ethgo.HexToAddress(v.(string))
+		t.To = &addr
+	}
+	if v, ok := tx["value"]; ok {
+		t.Value = big.NewInt(intFromIface(v))
+	}
+	if v, ok := tx["data"]; ok {
+		dataHex := v.(string)
+		if len(dataHex) > 2 && dataHex[:2] == "0x" {
+			dataHex = dataHex[2:]
+		}
+		d, _ := hex.DecodeString(dataHex)
+		t.Input = d
+	}
+	if v, ok := tx["chainId"]; ok {
+		t.ChainID = big.NewInt(intFromIface(v))
+	}
+	if v, ok := tx["accessList"]; ok {
+		al, err := accessListFromIface(v)
+		if err != nil {
+			return "", err
+		}
+		t.AccessList = al
+	}
+
+	pk, err := hex.DecodeString(privKeyHex)
+	if err != nil {
+		return "", err
+	}
+	key, err := wallet.NewWalletFromPrivKey(pk)
+	if err != nil {
+		return "", err
+	}
+
+	signer := wallet.NewEIP2930Signer(t.ChainID.Uint64())
+	signed, err := signer.SignTx(t, key)
+	if err != nil {
+		return "", err
+	}
+	raw, err := signed.MarshalRLPTo(nil)
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(raw), nil
+}
+
+// JS: ethgo.signDynamicFeeTx({nonce, gas, to, value, data, chainId, maxFeePerGas, maxPriorityFeePerGas, accessList}, privKeyHex) → hex string
+func (m *Module) SignDynamicFeeTx(tx map[string]interface{}, privKeyHex string) (string, error) {
+	t := &ethgo.Transaction{
+		Type: ethgo.TransactionDynamicFee,
+	}
+	if v, ok := tx["nonce"]; ok {
+		t.Nonce = uint64(intFromIface(v))
+	}
+	if v, ok := tx["gas"]; ok {
+		t.Gas = uint64(intFromIface(v))
+	}
+	if v, ok := tx["to"]; ok {
+		addr := ethgo.HexToAddress(v.(string))
+		t.To = &addr
+	}
+	if v, ok := tx["value"]; ok {
+		t.Value = big.NewInt(intFromIface(v))
+	}
+	if v, ok := tx["data"]; ok {
+		dataHex := v.(string)
+		if len(dataHex) > 2 && dataHex[:2] == "0x" {
+			dataHex = dataHex[2:]
+		}
+		d, _ := hex.DecodeString(dataHex)
+		t.Input = d
+	}
+	if v, ok := tx["chainId"]; ok {
+		t.ChainID = big.NewInt(intFromIface(v))
+	}
+	if v, ok := tx["maxFeePerGas"]; ok {
+		t.GasFeeCap = big.NewInt(intFromIface(v))
+	}
+	if v, ok := tx["maxPriorityFeePerGas"]; ok {
+		t.GasTipCap = big.NewInt(intFromIface(v))
+	}
+	if v, ok := tx["accessList"]; ok {
+		al, err := accessListFromIface(v)
+		if err != nil {
+			return "", err
+		}
+		t.AccessList = al
+	}
+
+	pk, err := hex.DecodeString(privKeyHex)
+	if err != nil {
+		return "", err
+	}
+	key, err := wallet.NewWalletFromPrivKey(pk)
+	if err != nil {
+		return "", err
+	}
+
+	signer := wallet.NewLondonSigner(t.ChainID.Uint64())
+	signed, err := signer.SignTx(t, key)
+	if err != nil {
+		return "", err
+	}
+	raw, err := signed.MarshalRLPTo(nil)
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(raw), nil
+}
+
+// accessListFromIface converts a JS array of {address, storageKeys[]} objects
+// into an ethgo.AccessList.
+func accessListFromIface(v interface{}) (ethgo.AccessList, error) {
+	entries, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("accessList must be an array")
+	}
+	al := make(ethgo.AccessList, 0, len(entries))
+	for _, e := range entries {
+		entry, ok := e.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("accessList entry must be an object")
+		}
+		addrStr, ok := entry["address"].(string)
+		if !ok {
+			return nil, fmt.Errorf("accessList entry missing address")
+		}
+		accessEntry := ethgo.AccessEntry{
+			Address: ethgo.HexToAddress(addrStr),
+		}
+		if keys, ok := entry["storageKeys"].([]interface{}); ok {
+			for _, k := range keys {
+				keyStr, ok := k.(string)
+				if !ok {
+					return nil, fmt.Errorf("storageKeys entries must be strings")
+				}
+				accessEntry.Storage = append(accessEntry.Storage, ethgo.HexToHash(keyStr))
+			}
+		}
+		al = append(al, accessEntry)
+	}
+	return al, nil
+}
+
 // JS: ethgo.hexToAddress(str) → "0x.."
 func (m *Module) HexToAddress(addr string) string {
 	return ethgo.HexToAddress(addr).String()
@@ -98,6 +257,75 @@ func intFromIface(v interface{}) int64 {
 	}
 }
 
+// JS: ethgo.signMessage(messageBytesOrString, privKeyHex) → "0x.." (65-byte signature)
+//
+// Prepends the "\x19Ethereum Signed Message:\n<len>" prefix used by
+// personal_sign, distinct from raw transaction signing, so load tests can
+// exercise login/SIWE/wallet-connect handshakes.
+func (m *Module) SignMessage(message interface{}, privKeyHex string) (string, error) {
+	digest := personalSignDigest(messageBytes(message))
+
+	pk, err := hex.DecodeString(privKeyHex)
+	if err != nil {
+		return "", err
+	}
+	key, err := wallet.NewWalletFromPrivKey(pk)
+	if err != nil {
+		return "", err
+	}
+	sig, err := key.Sign(digest)
+	if err != nil {
+		return "", err
+	}
+	if sig[64] < 27 {
+		sig[64] += 27
+	}
+	return "0x" + hex.EncodeToString(sig), nil
+}
+
+// JS: ethgo.recoverAddress(messageBytesOrString, signatureHex) → "0x.." address
+func (m *Module) RecoverAddress(message interface{}, signatureHex string) (string, error) {
+	digest := personalSignDigest(messageBytes(message))
+
+	sig := decodeHexArg(signatureHex)
+	if len(sig) != 65 {
+		return "", fmt.Errorf("signature must be 65 bytes, got %d", len(sig))
+	}
+	if sig[64] >= 27 {
+		sig = append(append([]byte{}, sig[:64]...), sig[64]-27)
+	}
+
+	addr, err := wallet.Ecrecover(digest, sig)
+	if err != nil {
+		return "", err
+	}
+	return addr.String(), nil
+}
+
+func personalSignDigest(msg []byte) []byte {
+	prefix := fmt.Sprintf("\x19Ethereum Signed Message:\n%d", len(msg))
+	return keccak256(append([]byte(prefix), msg...))
+}
+
+// messageBytes accepts either a JS string or a byte array and returns the
+// raw message bytes to be hashed.
+func messageBytes(v interface{}) []byte {
+	switch vv := v.(type) {
+	case string:
+		return []byte(vv)
+	case []byte:
+		return vv
+	case []interface{}:
+		b := make([]byte, len(vv))
+		for i, e := range vv {
+			b[i] = byte(intFromIface(e))
+		}
+		return b
+	default:
+		return nil
+	}
+}
+
 func (m *Module) PrivateKeyToAddress(privateKeyHex string) (string, error) {
 
 	pk, err := hex.DecodeString(privateKeyHex)
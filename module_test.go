@@ -74,3 +74,99 @@ func TestSignLegacyTx(t *testing.T) {
 	}
 	t.Logf("raw bytes: %s", rawHex)
 }
+
+func TestSignDynamicFeeTx(t *testing.T) {
+	tx := map[string]interface{}{
+		"to":                   "0x5fbdb2315678afecb367f032d93f642f64180aa3",
+		"value":                1000000000,
+		"chainId":              50312,
+		"gas":                  6 * 1e9,
+		"maxFeePerGas":         20000000000,
+		"maxPriorityFeePerGas": 1000000000,
+	}
+
+	pkBytes := bytes.Repeat([]byte{1}, 32)
+	pkHex := hex.EncodeToString(pkBytes)
+
+	m := &Module{}
+	rawHex, err := m.SignDynamicFeeTx(tx, pkHex)
+	if err != nil {
+		t.Fatalf("SignDynamicFeeTx error: %v", err)
+	}
+
+	if len(rawHex) < 3 || rawHex[:2] != "0x" {
+		t.Errorf("signed tx hex missing prefix '0x': %s", rawHex)
+	}
+	rawBytes, err := hex.DecodeString(rawHex[2:])
+	if err != nil {
+		t.Errorf("failed to decode signed tx hex: %v", err)
+	}
+	if len(rawBytes) == 0 {
+		t.Error("decoded tx bytes is empty")
+	}
+	if rawBytes[0] != 0x02 {
+		t.Errorf("expected type-2 prefix byte 0x02, got 0x%x", rawBytes[0])
+	}
+}
+
+func TestSignAccessListTx(t *testing.T) {
+	tx := map[string]interface{}{
+		"to":       "0x5fbdb2315678afecb367f032d93f642f64180aa3",
+		"value":    1000000000,
+		"chainId":  50312,
+		"gas":      6 * 1e9,
+		"gasPrice": 1000000000,
+		"accessList": []interface{}{
+			map[string]interface{}{
+				"address": "0x5fbdb2315678afecb367f032d93f642f64180aa3",
+				"storageKeys": []interface{}{
+					"0x0000000000000000000000000000000000000000000000000000000000000001",
+				},
+			},
+		},
+	}
+
+	pkBytes := bytes.Repeat([]byte{1}, 32)
+	pkHex := hex.EncodeToString(pkBytes)
+
+	m := &Module{}
+	rawHex, err := m.SignAccessListTx(tx, pkHex)
+	if err != nil {
+		t.Fatalf("SignAccessListTx error: %v", err)
+	}
+
+	rawBytes, err := hex.DecodeString(rawHex[2:])
+	if err != nil {
+		t.Errorf("failed to decode signed tx hex: %v", err)
+	}
+	if rawBytes[0] != 0x01 {
+		t.Errorf("expected type-1 prefix byte 0x01, got 0x%x", rawBytes[0])
+	}
+}
+
+func TestSignMessageAndRecoverAddress(t *testing.T) {
+	pkBytes := bytes.Repeat([]byte{1}, 32)
+	pkHex := hex.EncodeToString(pkBytes)
+
+	m := &Module{}
+	w, err := wallet.NewWalletFromPrivKey(pkBytes)
+	if err != nil {
+		t.Fatalf("wallet.NewWalletFromPrivKey error: %v", err)
+	}
+
+	sig, err := m.SignMessage("hello world", pkHex)
+	if err != nil {
+		t.Fatalf("SignMessage error: %v", err)
+	}
+	if len(sig) != 132 {
+		t.Errorf("expected 65-byte 0x-prefixed signature (132 chars), got %d: %s", len(sig), sig)
+	}
+
+	addr, err := m.RecoverAddress("hello world", sig)
+	if err != nil {
+		t.Fatalf("RecoverAddress error: %v", err)
+	}
+	if addr != w.Address().String() {
+		t.Errorf("RecoverAddress() = %s; want %s", addr, w.Address().String())
+	}
+}
@@ -0,0 +1,387 @@
+package ethgo
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/wallet"
+	"golang.org/x/crypto/sha3"
+)
+
+type eip712Field struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type eip712TypedData struct {
+	Types       map[string][]eip712Field `json:"types"`
+	PrimaryType string                   `json:"primaryType"`
+	Domain      map[string]interface{}   `json:"domain"`
+	Message     map[string]interface{}   `json:"message"`
+}
+
+// JS: ethgo.hashTypedData(typedData) → "0x.." (32-byte EIP-712 digest)
+func (m *Module) HashTypedData(data map[string]interface{}) (string, error) {
+	digest, err := hashTypedData(data)
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(digest), nil
+}
+
+// JS: ethgo.signTypedData(typedData, privKeyHex) → "0x.." (65-byte signature)
+func (m *Module) SignTypedData(data map[string]interface{}, privKeyHex string) (string, error) {
+	digest, err := hashTypedData(data)
+	if err != nil {
+		return "", err
+	}
+
+	pk, err := hex.DecodeString(privKeyHex)
+	if err != nil {
+		return "", err
+	}
+	key, err := wallet.NewWalletFromPrivKey(pk)
+	if err != nil {
+		return "", err
+	}
+	sig, err := key.Sign(digest)
+	if err != nil {
+		return "", err
+	}
+	if sig[64] < 27 {
+		sig[64] += 27
+	}
+	return "0x" + hex.EncodeToString(sig), nil
+}
+
+// hashTypedData computes keccak256("\x19\x01" || domainSeparator || hashStruct(message))
+// per EIP-712.
+func hashTypedData(raw map[string]interface{}) ([]byte, error) {
+	td, err := parseTypedData(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	domainHash, err := hashStruct(td, "EIP712Domain", td.Domain)
+	if err != nil {
+		return nil, fmt.Errorf("hash domain: %w", err)
+	}
+	messageHash, err := hashStruct(td, td.PrimaryType, td.Message)
+	if err != nil {
+		return nil, fmt.Errorf("hash message: %w", err)
+	}
+
+	preimage := append([]byte{0x19, 0x01}, domainHash...)
+	preimage = append(preimage, messageHash...)
+	return keccak256(preimage), nil
+}
+
+func parseTypedData(raw map[string]interface{}) (*eip712TypedData, error) {
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	td := &eip712TypedData{}
+	if err := json.Unmarshal(b, td); err != nil {
+		return nil, err
+	}
+	if td.PrimaryType == "" {
+		return nil, fmt.Errorf("typed data missing primaryType")
+	}
+	if _, ok := td.Types[td.PrimaryType]; !ok {
+		return nil, fmt.Errorf("unknown primaryType %q", td.PrimaryType)
+	}
+	return td, nil
+}
+
+// encodeType returns the canonical EIP-712 type signature for typeName, e.g.
+// "Mail(Person from,Person to,string contents)Person(string name,address wallet)".
+func encodeType(types map[string][]eip712Field, typeName string) (string, error) {
+	deps := typeDependencies(types, typeName, map[string]bool{})
+	sort.Strings(deps)
+	ordered := append([]string{typeName}, removeFromSlice(deps, typeName)...)
+
+	var sb strings.Builder
+	for _, name := range ordered {
+		fields, ok := types[name]
+		if !ok {
+			return "", fmt.Errorf("undefined type %q", name)
+		}
+		sb.WriteString(name)
+		sb.WriteString("(")
+		for i, f := range fields {
+			if i > 0 {
+				sb.WriteString(",")
+			}
+			sb.WriteString(f.Type)
+			sb.WriteString(" ")
+			sb.WriteString(f.Name)
+		}
+		sb.WriteString(")")
+	}
+	return sb.String(), nil
+}
+
+// typeDependencies walks typeName's fields recursively, collecting every
+// struct type it (transitively) references.
+func typeDependencies(types map[string][]eip712Field, typeName string, seen map[string]bool) []string {
+	base := strings.TrimSuffix(typeName, "[]")
+	if seen[base] {
+		return nil
+	}
+	fields, ok := types[base]
+	if !ok {
+		return nil
+	}
+	seen[base] = true
+
+	deps := []string{base}
+	for _, f := range fields {
+		deps = append(deps, typeDependencies(types, f.Type, seen)...)
+	}
+	return deps
+}
+
+func removeFromSlice(s []string, v string) []string {
+	out := make([]string, 0, len(s))
+	for _, e := range s {
+		if e != v {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+func typeHash(types map[string][]eip712Field, typeName string) ([]byte, error) {
+	encoded, err := encodeType(types, typeName)
+	if err != nil {
+		return nil, err
+	}
+	return keccak256([]byte(encoded)), nil
+}
+
+func hashStruct(td *eip712TypedData, typeName string, data map[string]interface{}) ([]byte, error) {
+	encoded, err := encodeData(td, typeName, data)
+	if err != nil {
+		return nil, err
+	}
+	return keccak256(encoded), nil
+}
+
+func encodeData(td *eip712TypedData, typeName string, data map[string]interface{}) ([]byte, error) {
+	th, err := typeHash(td.Types, typeName)
+	if err != nil {
+		return nil, err
+	}
+	fields, ok := td.Types[typeName]
+	if !ok {
+		return nil, fmt.Errorf("undefined type %q", typeName)
+	}
+
+	out := th
+	for _, f := range fields {
+		v, ok := data[f.Name]
+		if !ok {
+			return nil, fmt.Errorf("missing field %q of type %q", f.Name, f.Type)
+		}
+		enc, err := encodeValue(td, f.Type, v)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", f.Name, err)
+		}
+		out = append(out, enc...)
+	}
+	return out, nil
+}
+
+// encodeValue ABI-encodes a single EIP-712 field value to its 32-byte word,
+// hashing dynamic types (string, bytes, arrays, structs) per encodeData rules.
+func encodeValue(td *eip712TypedData, typ string, value interface{}) ([]byte, error) {
+	switch {
+	case strings.HasSuffix(typ, "[]"):
+		elems, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected array for type %q", typ)
+		}
+		elemType := strings.TrimSuffix(typ, "[]")
+		var packed []byte
+		for _, e := range elems {
+			enc, err := encodeValue(td, elemType, e)
+			if err != nil {
+				return nil, err
+			}
+			packed = append(packed, enc...)
+		}
+		return keccak256(packed), nil
+
+	case typ == "string":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected string value, got %T", value)
+		}
+		return keccak256([]byte(s)), nil
+
+	case typ == "bytes":
+		b, err := decodeHexOrRaw(value)
+		if err != nil {
+			return nil, err
+		}
+		return keccak256(b), nil
+
+	case strings.HasPrefix(typ, "bytes"):
+		b, err := decodeHexOrRaw(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) > 32 {
+			return nil, fmt.Errorf("%s value exceeds 32 bytes", typ)
+		}
+		word := make([]byte, 32)
+		copy(word, b)
+		return word, nil
+
+	case typ == "bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected bool value, got %T", value)
+		}
+		word := make([]byte, 32)
+		if b {
+			word[31] = 1
+		}
+		return word, nil
+
+	case typ == "address":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected address string, got %T", value)
+		}
+		addr := ethgo.HexToAddress(s)
+		word := make([]byte, 32)
+		copy(word[12:], addr[:])
+		return word, nil
+
+	case strings.HasPrefix(typ, "uint"):
+		n, err := bigIntFromIface(value)
+		if err != nil {
+			return nil, err
+		}
+		bits, err := intTypeBits(typ, "uint")
+		if err != nil {
+			return nil, err
+		}
+		max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(bits)), big.NewInt(1))
+		if n.Sign() < 0 || n.Cmp(max) > 0 {
+			return nil, fmt.Errorf("%s value %s out of range [0, %s]", typ, n.String(), max.String())
+		}
+		word := make([]byte, 32)
+		b := n.Bytes()
+		copy(word[32-len(b):], b)
+		return word, nil
+
+	case strings.HasPrefix(typ, "int"):
+		n, err := bigIntFromIface(value)
+		if err != nil {
+			return nil, err
+		}
+		bits, err := intTypeBits(typ, "int")
+		if err != nil {
+			return nil, err
+		}
+		half := new(big.Int).Lsh(big.NewInt(1), uint(bits-1))
+		max := new(big.Int).Sub(half, big.NewInt(1))
+		min := new(big.Int).Neg(half)
+		if n.Cmp(min) < 0 || n.Cmp(max) > 0 {
+			return nil, fmt.Errorf("%s value %s out of range [%s, %s]", typ, n.String(), min.String(), max.String())
+		}
+
+		word := make([]byte, 32)
+		if n.Sign() < 0 {
+			// Two's-complement encode: a negative intN is sign-extended to
+			// the full 32-byte word as n + 2^256, per the Solidity ABI.
+			twos := new(big.Int).Add(n, new(big.Int).Lsh(big.NewInt(1), 256))
+			b := twos.Bytes()
+			copy(word[32-len(b):], b)
+			for i := 0; i < 32-len(b); i++ {
+				word[i] = 0xff
+			}
+		} else {
+			b := n.Bytes()
+			copy(word[32-len(b):], b)
+		}
+		return word, nil
+
+	default:
+		if _, ok := td.Types[typ]; ok {
+			sub, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("expected object for struct type %q", typ)
+			}
+			return hashStruct(td, typ, sub)
+		}
+		return nil, fmt.Errorf("unsupported type %q", typ)
+	}
+}
+
+// intTypeBits returns the bit width of a Solidity uintN/intN type name,
+// defaulting to 256 for the bare "uint"/"int" alias. It rejects any suffix
+// that isn't a multiple of 8 in [8, 256], the only widths Solidity allows.
+func intTypeBits(typ, prefix string) (int, error) {
+	suffix := strings.TrimPrefix(typ, prefix)
+	if suffix == "" {
+		return 256, nil
+	}
+	bits, err := strconv.Atoi(suffix)
+	if err != nil {
+		return 0, fmt.Errorf("invalid integer type %q", typ)
+	}
+	if bits < 8 || bits > 256 || bits%8 != 0 {
+		return 0, fmt.Errorf("invalid integer type %q: width must be a multiple of 8 between 8 and 256", typ)
+	}
+	return bits, nil
+}
+
+func decodeHexOrRaw(value interface{}) ([]byte, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected hex string, got %T", value)
+	}
+	if len(s) > 2 && s[:2] == "0x" {
+		s = s[2:]
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex string: %w", err)
+	}
+	return b, nil
+}
+
+func bigIntFromIface(v interface{}) (*big.Int, error) {
+	switch vv := v.(type) {
+	case float64:
+		return big.NewInt(int64(vv)), nil
+	case string:
+		trimmed := strings.TrimPrefix(vv, "0x")
+		if n, ok := new(big.Int).SetString(trimmed, 16); ok && strings.HasPrefix(vv, "0x") {
+			return n, nil
+		}
+		n, ok := new(big.Int).SetString(vv, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %q", vv)
+		}
+		return n, nil
+	default:
+		return nil, fmt.Errorf("unsupported numeric type %T", v)
+	}
+}
+
+func keccak256(data []byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	h.Write(data)
+	return h.Sum(nil)
+}
@@ -0,0 +1,178 @@
+package ethgo
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+// Canonical "Mail" example from the EIP-712 specification.
+func mailTypedData() map[string]interface{} {
+	return map[string]interface{}{
+		"types": map[string]interface{}{
+			"EIP712Domain": []interface{}{
+				map[string]interface{}{"name": "name", "type": "string"},
+				map[string]interface{}{"name": "version", "type": "string"},
+				map[string]interface{}{"name": "chainId", "type": "uint256"},
+				map[string]interface{}{"name": "verifyingContract", "type": "address"},
+			},
+			"Person": []interface{}{
+				map[string]interface{}{"name": "name", "type": "string"},
+				map[string]interface{}{"name": "wallet", "type": "address"},
+			},
+			"Mail": []interface{}{
+				map[string]interface{}{"name": "from", "type": "Person"},
+				map[string]interface{}{"name": "to", "type": "Person"},
+				map[string]interface{}{"name": "contents", "type": "string"},
+			},
+		},
+		"primaryType": "Mail",
+		"domain": map[string]interface{}{
+			"name":              "Ether Mail",
+			"version":           "1",
+			"chainId":           1,
+			"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+		},
+		"message": map[string]interface{}{
+			"from": map[string]interface{}{
+				"name":   "Cow",
+				"wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826",
+			},
+			"to": map[string]interface{}{
+				"name":   "Bob",
+				"wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbbBbBbbbbBbBbbBBbB",
+			},
+			"contents": "Hello, Bob!",
+		},
+	}
+}
+
+func TestHashTypedData(t *testing.T) {
+	m := &Module{}
+	got, err := m.HashTypedData(mailTypedData())
+	if err != nil {
+		t.Fatalf("HashTypedData error: %v", err)
+	}
+
+	want := "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"
+	if got != want {
+		t.Errorf("HashTypedData() = %s; want %s", got, want)
+	}
+}
+
+func TestSignTypedData(t *testing.T) {
+	m := &Module{}
+	privKeyHex := "c85ef7d79691fe79573b1a7064c19c1a9819ebdbd1faaab1a8ec92344438aaf4"
+
+	sig, err := m.SignTypedData(mailTypedData(), privKeyHex)
+	if err != nil {
+		t.Fatalf("SignTypedData error: %v", err)
+	}
+	if len(sig) != 132 {
+		t.Errorf("expected 65-byte 0x-prefixed signature (132 chars), got %d: %s", len(sig), sig)
+	}
+}
+
+func int256TypedData(amount interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"types": map[string]interface{}{
+			"EIP712Domain": []interface{}{
+				map[string]interface{}{"name": "name", "type": "string"},
+			},
+			"Order": []interface{}{
+				map[string]interface{}{"name": "amount", "type": "int256"},
+			},
+		},
+		"primaryType": "Order",
+		"domain": map[string]interface{}{
+			"name": "Test",
+		},
+		"message": map[string]interface{}{
+			"amount": amount,
+		},
+	}
+}
+
+func TestHashTypedDataNegativeIntTwosComplement(t *testing.T) {
+	m := &Module{}
+
+	got, err := m.HashTypedData(int256TypedData(float64(-5)))
+	if err != nil {
+		t.Fatalf("HashTypedData error: %v", err)
+	}
+
+	// Recompute the expected digest by hand: the int256 word for -5 is
+	// 2^256 - 5, i.e. 0xff...fb.
+	word := strings.Repeat("ff", 31) + "fb"
+	orderTypeHash := keccak256([]byte("Order(int256 amount)"))
+	wordBytes, err := hex.DecodeString(word)
+	if err != nil {
+		t.Fatalf("bad test fixture: %v", err)
+	}
+	hashStructMsg := keccak256(append(append([]byte{}, orderTypeHash...), wordBytes...))
+
+	domainTypeHash := keccak256([]byte("EIP712Domain(string name)"))
+	nameHash := keccak256([]byte("Test"))
+	domainSeparator := keccak256(append(append([]byte{}, domainTypeHash...), nameHash...))
+
+	preimage := append([]byte{0x19, 0x01}, domainSeparator...)
+	preimage = append(preimage, hashStructMsg...)
+	want := "0x" + hex.EncodeToString(keccak256(preimage))
+
+	if got != want {
+		t.Errorf("HashTypedData() with negative int256 = %s; want %s", got, want)
+	}
+}
+
+func TestHashTypedDataInt256OutOfRange(t *testing.T) {
+	m := &Module{}
+
+	// 2^255 is positive but would flip the sign bit of a 32-byte word,
+	// i.e. it's outside the signed int256 range [-2^255, 2^255-1].
+	tooLarge := new(big.Int).Lsh(big.NewInt(1), 255)
+	if _, err := m.HashTypedData(int256TypedData(tooLarge.String())); err == nil {
+		t.Error("expected error for int256 value 2^255 (out of signed range), got nil")
+	}
+
+	// A negative value more negative than -2^255 must also be rejected
+	// rather than silently wrapping during two's-complement encoding.
+	tooSmall := new(big.Int).Neg(new(big.Int).Add(tooLarge, big.NewInt(5)))
+	if _, err := m.HashTypedData(int256TypedData(tooSmall.String())); err == nil {
+		t.Error("expected error for int256 value below -2^255, got nil")
+	}
+}
+
+func TestHashTypedDataInvalidIntWidth(t *testing.T) {
+	m := &Module{}
+
+	data := int256TypedData(float64(1))
+	orderFields := data["types"].(map[string]interface{})["Order"].([]interface{})
+	orderFields[0].(map[string]interface{})["type"] = "int264"
+
+	if _, err := m.HashTypedData(data); err == nil {
+		t.Error("expected error for invalid integer width int264, got nil")
+	}
+}
+
+func TestHashTypedDataMissingField(t *testing.T) {
+	m := &Module{}
+	data := mailTypedData()
+	message := data["message"].(map[string]interface{})
+	delete(message, "contents")
+
+	if _, err := m.HashTypedData(data); err == nil {
+		t.Error("expected error for missing field, got nil")
+	}
+}
+
+func TestHashTypedDataWrongFieldType(t *testing.T) {
+	m := &Module{}
+	data := mailTypedData()
+	message := data["message"].(map[string]interface{})
+	message["contents"] = 12345 // declared as string
+
+	if _, err := m.HashTypedData(data); err == nil {
+		t.Error("expected error for wrong field type, got nil")
+	}
+}
@@ -0,0 +1,86 @@
+package ethgo
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	hdwallet "github.com/miguelmota/go-ethereum-hdwallet"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// JS: ethgo.newMnemonic(bits) → mnemonic string
+//
+// bits must be one of 128, 160, 192, 224, 256 (12-24 words), per BIP-39.
+func (m *Module) NewMnemonic(bits int) (string, error) {
+	entropy, err := bip39.NewEntropy(bits)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// JS: ethgo.mnemonicToSeed(mnemonic, passphrase) → "0x.." (64-byte BIP-39 seed)
+func (m *Module) MnemonicToSeed(mnemonic string, passphrase string) (string, error) {
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return "", fmt.Errorf("invalid mnemonic")
+	}
+	seed := bip39.NewSeed(mnemonic, passphrase)
+	return "0x" + hex.EncodeToString(seed), nil
+}
+
+// JS: ethgo.deriveKey(seedHex, path) → {privateKey, address}
+//
+// path follows BIP-32/BIP-44 notation, e.g. "m/44'/60'/0'/0/i", letting a
+// single VU derive thousands of accounts from one seed without a hard-coded
+// key list.
+func (m *Module) DeriveKey(seedHex string, path string) (map[string]interface{}, error) {
+	if len(seedHex) > 2 && seedHex[:2] == "0x" {
+		seedHex = seedHex[2:]
+	}
+	seed, err := hex.DecodeString(seedHex)
+	if err != nil {
+		return nil, err
+	}
+
+	wlt, err := hdwallet.NewFromSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+	derivationPath, err := hdwallet.ParseDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+	account, err := wlt.Derive(derivationPath, false)
+	if err != nil {
+		return nil, err
+	}
+	privKey, err := wlt.PrivateKeyBytes(account)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"privateKey": "0x" + hex.EncodeToString(privKey),
+		"address":    account.Address.Hex(),
+	}, nil
+}
+
+// JS: ethgo.loadKeystoreV3(json, password) → {privateKey, address}
+//
+// Decrypts a geth-format (web3 secret storage, V3) encrypted keystore so
+// load tests can consume existing funded accounts directly instead of
+// re-deriving or hard-coding raw keys.
+func (m *Module) LoadKeystoreV3(keystoreJSON string, password string) (map[string]interface{}, error) {
+	key, err := keystore.DecryptKey([]byte(keystoreJSON), password)
+	if err != nil {
+		return nil, err
+	}
+	privKeyBytes := crypto.FromECDSA(key.PrivateKey)
+
+	return map[string]interface{}{
+		"privateKey": "0x" + hex.EncodeToString(privKeyBytes),
+		"address":    key.Address.Hex(),
+	}, nil
+}
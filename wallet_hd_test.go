@@ -0,0 +1,42 @@
+package ethgo
+
+import "testing"
+
+func TestMnemonicToSeedAndDeriveKey(t *testing.T) {
+	// The well-known default Hardhat/Ganache test mnemonic.
+	mnemonic := "test test test test test test test test test test test junk"
+
+	m := &Module{}
+	seedHex, err := m.MnemonicToSeed(mnemonic, "")
+	if err != nil {
+		t.Fatalf("MnemonicToSeed error: %v", err)
+	}
+
+	account, err := m.DeriveKey(seedHex, "m/44'/60'/0'/0/0")
+	if err != nil {
+		t.Fatalf("DeriveKey error: %v", err)
+	}
+
+	wantAddr := "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+	if account["address"] != wantAddr {
+		t.Errorf("DeriveKey address = %v; want %s", account["address"], wantAddr)
+	}
+}
+
+func TestMnemonicToSeedInvalid(t *testing.T) {
+	m := &Module{}
+	if _, err := m.MnemonicToSeed("not a valid mnemonic", ""); err == nil {
+		t.Error("expected error for invalid mnemonic, got nil")
+	}
+}
+
+func TestNewMnemonic(t *testing.T) {
+	m := &Module{}
+	mnemonic, err := m.NewMnemonic(128)
+	if err != nil {
+		t.Fatalf("NewMnemonic error: %v", err)
+	}
+	if len(mnemonic) == 0 {
+		t.Error("expected non-empty mnemonic")
+	}
+}